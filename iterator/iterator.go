@@ -0,0 +1,242 @@
+/*
+This package provides a lazy, push-based sequence type inspired by Go's iter.Seq, so that chains of
+Map/Filter/Take/... over a slice.Slice[T] can run in a single pass without allocating an intermediate
+slice at every step.
+
+Use From() to turn a slice into a Seq[T], chain lazy transforms on it, and finish with a terminal such
+as Collect() or Reduce(). Nothing runs until a terminal pulls values through the pipeline.
+*/
+package iterator
+
+// Seq[T] is a lazy sequence of T. Calling it with a yield function pushes elements through one at a
+// time; yield should return false to stop iteration early.
+type Seq[T any] func(yield func(T) bool)
+
+// The From() function returns a Seq over the elements of s, in order.
+func From[T any](s []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+/*
+The Filter() method returns a Seq containing only the elements for which match returns true.
+
+The match function is called for each element. If it returns true, the element is passed downstream.
+*/
+func (seq Seq[T]) Filter(match func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			if !match(v) {
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// The Take() method returns a Seq of at most the first n elements, stopping the upstream Seq early once n have been produced.
+func (seq Seq[T]) Take(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		seq(func(v T) bool {
+			if !yield(v) {
+				return false
+			}
+			count++
+			return count < n
+		})
+	}
+}
+
+// The Drop() method returns a Seq with the first n elements skipped.
+func (seq Seq[T]) Drop(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		count := 0
+		seq(func(v T) bool {
+			if count < n {
+				count++
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// The TakeWhile() method returns a Seq of the leading elements for which match returns true, stopping at the first element that fails the test.
+func (seq Seq[T]) TakeWhile(match func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			if !match(v) {
+				return false
+			}
+			return yield(v)
+		})
+	}
+}
+
+// The DropWhile() method returns a Seq with the leading elements for which match returns true skipped, yielding every element from the first failure onward.
+func (seq Seq[T]) DropWhile(match func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		seq(func(v T) bool {
+			if dropping {
+				if match(v) {
+					return true
+				}
+				dropping = false
+			}
+			return yield(v)
+		})
+	}
+}
+
+/*
+The Chunk() function returns a Seq of consecutive, non-overlapping chunks of size elements each. The last chunk may be shorter. It panics if size<=0.
+*/
+func Chunk[T any](seq Seq[T], size int) Seq[[]T] {
+	if size <= 0 {
+		panic("iterator.Chunk: size must be positive")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		stopped := false
+		seq(func(v T) bool {
+			chunk = append(chunk, v)
+			if len(chunk) < size {
+				return true
+			}
+			c := chunk
+			chunk = make([]T, 0, size)
+			if !yield(c) {
+				stopped = true
+				return false
+			}
+			return true
+		})
+		if !stopped && len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// The Map() function returns a Seq with f applied to every element of seq.
+func Map[T, U any](seq Seq[T], f func(T) U) Seq[U] {
+	return func(yield func(U) bool) {
+		seq(func(v T) bool {
+			return yield(f(v))
+		})
+	}
+}
+
+// The Flatten() function returns a Seq over the concatenated elements of each chunk produced by seq, in order.
+func Flatten[T any](seq Seq[[]T]) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(chunk []T) bool {
+			for _, v := range chunk {
+				if !yield(v) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+/*
+The Reduce() function applies an accumulator function over the elements of seq, returning a single value.
+
+The initial argument is the starting value of the accumulator. The reducer function takes the current accumulator and an element and returns the new accumulator.
+*/
+func Reduce[T, U any](seq Seq[T], initial U, reducer func(U, T) U) U {
+	accumulator := initial
+	seq(func(v T) bool {
+		accumulator = reducer(accumulator, v)
+		return true
+	})
+	return accumulator
+}
+
+// The Collect() method pulls every element through the pipeline and returns them as a plain slice.
+func (seq Seq[T]) Collect() []T {
+	var result []T
+	seq(func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return result
+}
+
+// The Count() method pulls every element through the pipeline and returns how many there were.
+func (seq Seq[T]) Count() int {
+	count := 0
+	seq(func(T) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// The First() method returns the first element of the pipeline and true, or a zero value and false if it is empty.
+func (seq Seq[T]) First() (T, bool) {
+	var result T
+	found := false
+	seq(func(v T) bool {
+		result = v
+		found = true
+		return false
+	})
+	return result, found
+}
+
+/*
+The Find() method returns the first element for which match returns true, and true, or a zero value and false if no element matches.
+
+The match function is called for each element until one passes the test.
+*/
+func (seq Seq[T]) Find(match func(T) bool) (T, bool) {
+	var result T
+	found := false
+	seq(func(v T) bool {
+		if !match(v) {
+			return true
+		}
+		result = v
+		found = true
+		return false
+	})
+	return result, found
+}
+
+// The Any() method reports whether match returns true for at least one element of the pipeline, stopping early once one is found.
+func (seq Seq[T]) Any(match func(T) bool) bool {
+	found := false
+	seq(func(v T) bool {
+		if !match(v) {
+			return true
+		}
+		found = true
+		return false
+	})
+	return found
+}
+
+// The All() method reports whether match returns true for every element of the pipeline, stopping early at the first failure.
+func (seq Seq[T]) All(match func(T) bool) bool {
+	all := true
+	seq(func(v T) bool {
+		if match(v) {
+			return true
+		}
+		all = false
+		return false
+	})
+	return all
+}
@@ -0,0 +1,65 @@
+package iterator
+
+import "testing"
+
+// infinite returns a Seq over 0, 1, 2, ... that records how many elements the
+// underlying source produced, so tests can assert a downstream stage stopped
+// pulling as soon as it had what it needed.
+func infinite(pulled *int) Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestTakeStopsEarly(t *testing.T) {
+	pulled := 0
+	got := infinite(&pulled).Take(3).Collect()
+	want := []int{0, 1, 2}
+	if len(got) != len(want) {
+		t.Fatalf("Take(3).Collect() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Take(3).Collect() = %v, want %v", got, want)
+		}
+	}
+	if pulled != 3 {
+		t.Errorf("Take(3) pulled %d elements from an infinite source, want 3", pulled)
+	}
+}
+
+func TestTakeWhileStopsEarly(t *testing.T) {
+	pulled := 0
+	got := infinite(&pulled).TakeWhile(func(v int) bool { return v < 3 }).Collect()
+	want := []int{0, 1, 2}
+	if len(got) != len(want) {
+		t.Fatalf("TakeWhile(<3).Collect() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("TakeWhile(<3).Collect() = %v, want %v", got, want)
+		}
+	}
+	// One extra element (the first failing one) must be pulled to detect the end of the run.
+	if pulled != 4 {
+		t.Errorf("TakeWhile(<3) pulled %d elements from an infinite source, want 4", pulled)
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	got := From([]int{1, 2, 3, 4, 1, 2}).DropWhile(func(v int) bool { return v < 3 }).Collect()
+	want := []int{3, 4, 1, 2}
+	if len(got) != len(want) {
+		t.Fatalf("DropWhile(<3).Collect() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("DropWhile(<3).Collect() = %v, want %v", got, want)
+		}
+	}
+}
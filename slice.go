@@ -16,9 +16,14 @@ For example: tasks := Slice[Task]{} or listOfString := Slice[string]{}
 package slice
 
 import (
+	"cmp"
+	"context"
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
+
+	"github.com/agmeeder/slice/iterator"
 )
 
 // Defining a generic slice type
@@ -450,3 +455,684 @@ func (s Slice[T]) LastIndexOf(match func(T) bool) int {
 	}
 	return -1
 }
+
+// The Clone() method returns a copy of the slice. The elements are copied using assignment, so this is a shallow clone.
+func (s Slice[T]) Clone() Slice[T] {
+	if s == nil {
+		return nil
+	}
+	result := make(Slice[T], len(s))
+	copy(result, s)
+	return result
+}
+
+// The Clip() method removes unused capacity from the slice in-place, so that len(s) == cap(s).
+func (s *Slice[T]) Clip() *Slice[T] {
+	*s = (*s)[:len(*s):len(*s)]
+	return s
+}
+
+/*
+The Equal() method reports whether the slice and other contain the same elements in the same order.
+
+The eq function is called with elements at the same position in both slices. It should return true if the two elements are considered equal.
+*/
+func (s Slice[T]) Equal(other Slice[T], eq func(T, T) bool) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for i := range s {
+		if !eq(s[i], other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// The Equal() function reports whether s and other are the same length and contain the same elements in the same order, compared with ==. See also the Equal() method for non-comparable T.
+func Equal[T comparable](s, other Slice[T]) bool {
+	return s.Equal(other, func(a, b T) bool { return a == b })
+}
+
+/*
+The Contains() method reports whether target is present in the slice.
+
+The eq function is called with target and each element. It should return true if the two are considered equal.
+*/
+func (s Slice[T]) Contains(target T, eq func(T, T) bool) bool {
+	for _, v := range s {
+		if eq(v, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// The Contains() function reports whether target is present in s, compared with ==. See also the Contains() method for non-comparable T.
+func Contains[T comparable](s Slice[T], target T) bool {
+	return s.Contains(target, func(a, b T) bool { return a == b })
+}
+
+/*
+The Compact() method removes consecutive runs of equal elements from the slice in-place, keeping only the first element of each run. See also Distinct() for order-independent deduplication.
+
+The eq function is called with adjacent elements. It should return true if the two elements are considered equal.
+*/
+func (s *Slice[T]) Compact(eq func(T, T) bool) *Slice[T] {
+	l := len(*s)
+	if l < 2 {
+		return s
+	}
+	result := (*s)[:1]
+	for i := 1; i < l; i++ {
+		if !eq(result[len(result)-1], (*s)[i]) {
+			result = append(result, (*s)[i])
+		}
+	}
+	*s = result
+	return s
+}
+
+// The Compact() function removes consecutive runs of equal elements from s in-place, compared with ==, keeping only the first element of each run. See also the Compact() method for non-comparable T.
+func Compact[T comparable](s *Slice[T]) *Slice[T] {
+	return s.Compact(func(a, b T) bool { return a == b })
+}
+
+/*
+The Insert() method inserts the given values at the provided index, shifting elements after index up, and returns the modified slice.
+
+The index argument is the position at which to insert the values.
+*/
+func (s *Slice[T]) Insert(index int, values ...T) *Slice[T] {
+	return s.Splice(index, 0, values...)
+}
+
+/*
+The Delete() method removes the elements in the range [start, end) from the slice in-place and returns the modified slice. See also Slice() and Splice().
+*/
+func (s *Slice[T]) Delete(start, end int) *Slice[T] {
+	return s.Splice(start, end-start)
+}
+
+/*
+The Replace() method replaces the elements in the range [start, end) with values and returns the modified slice.
+*/
+func (s *Slice[T]) Replace(start, end int, values ...T) *Slice[T] {
+	return s.Splice(start, end-start, values...)
+}
+
+/*
+The Min() method returns the smallest element in the slice, according to the less function. It panics if the slice is empty.
+
+The less function parameter specifies a less-than comparison between two elements that returns true if the first argument is less than the second.
+*/
+func (s Slice[T]) Min(less func(T, T) bool) T {
+	if len(s) == 0 {
+		panic("slice.Min: empty slice")
+	}
+	min := s[0]
+	for _, v := range s[1:] {
+		if less(v, min) {
+			min = v
+		}
+	}
+	return min
+}
+
+/*
+The Max() method returns the largest element in the slice, according to the less function. It panics if the slice is empty.
+
+The less function parameter specifies a less-than comparison between two elements that returns true if the first argument is less than the second.
+*/
+func (s Slice[T]) Max(less func(T, T) bool) T {
+	if len(s) == 0 {
+		panic("slice.Max: empty slice")
+	}
+	max := s[0]
+	for _, v := range s[1:] {
+		if less(max, v) {
+			max = v
+		}
+	}
+	return max
+}
+
+/*
+The BinarySearchFunc() function searches for target in a slice that is sorted in ascending order, as reported by cmp. See also BinarySearch() for the cmp.Ordered case.
+
+The cmp function compares its two arguments, returning a negative number if the first is smaller, zero if they are equal, and a positive number if the first is larger.
+
+It returns the smallest index at which target was found, and true, or the index at which target would need to be inserted to keep the slice sorted, and false.
+*/
+func BinarySearchFunc[T any](s Slice[T], target T, cmp func(T, T) int) (int, bool) {
+	low, high := 0, len(s)
+	for low < high {
+		mid := (low + high) / 2
+		if cmp(s[mid], target) < 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low, low < len(s) && cmp(s[low], target) == 0
+}
+
+// The BinarySearch() function searches for target in a slice that is sorted in ascending order, using cmp.Compare. See also BinarySearchFunc() for non-cmp.Ordered T.
+func BinarySearch[T cmp.Ordered](s Slice[T], target T) (int, bool) {
+	return BinarySearchFunc(s, target, cmp.Compare[T])
+}
+
+/*
+The DistinctKey() function returns a new slice keeping only the first element seen for each key, preserving the original order.
+
+The key function extracts the comparable key used to identify duplicates. See also Distinct() for the comparable T case.
+*/
+func DistinctKey[T any, K comparable](s Slice[T], key func(T) K) Slice[T] {
+	seen := make(map[K]struct{}, len(s))
+	result := make(Slice[T], 0, len(s))
+	for _, v := range s {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// The Distinct() function returns a new slice keeping only the first occurrence of each element, preserving the original order. See also DistinctKey() for non-comparable T.
+func Distinct[T comparable](s Slice[T]) Slice[T] {
+	return DistinctKey(s, func(v T) T { return v })
+}
+
+/*
+The UnionKey() function returns a new slice containing the distinct elements of s followed by the distinct elements of other that were not already present, in order.
+
+The key function extracts the comparable key used to identify duplicates. See also Union() for the comparable T case.
+*/
+func UnionKey[T any, K comparable](s, other Slice[T], key func(T) K) Slice[T] {
+	seen := make(map[K]struct{}, len(s)+len(other))
+	result := make(Slice[T], 0, len(s)+len(other))
+	for _, v := range s {
+		k := key(v)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	for _, v := range other {
+		k := key(v)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// The Union() function returns a new slice containing the distinct elements of s followed by the distinct elements of other that were not already present, in order. See also UnionKey() for non-comparable T.
+func Union[T comparable](s, other Slice[T]) Slice[T] {
+	return UnionKey(s, other, func(v T) T { return v })
+}
+
+/*
+The IntersectionKey() function returns a new slice of the distinct elements of s whose key is also present in other, in the order they appear in s.
+
+The key function extracts the comparable key used to match elements. See also Intersection() for the comparable T case.
+*/
+func IntersectionKey[T any, K comparable](s, other Slice[T], key func(T) K) Slice[T] {
+	counts := make(map[K]int, len(other))
+	for _, v := range other {
+		counts[key(v)]++
+	}
+	seen := make(map[K]struct{}, len(s))
+	result := make(Slice[T], 0)
+	for _, v := range s {
+		k := key(v)
+		if counts[k] == 0 {
+			continue
+		}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// The Intersection() function returns a new slice of the distinct elements of s that are also present in other, in the order they appear in s. See also IntersectionKey() for non-comparable T.
+func Intersection[T comparable](s, other Slice[T]) Slice[T] {
+	return IntersectionKey(s, other, func(v T) T { return v })
+}
+
+/*
+The DifferenceKey() function returns a new slice of the distinct elements of s whose key is not present in other, in the order they appear in s.
+
+The key function extracts the comparable key used to match elements. See also Difference() for the comparable T case.
+*/
+func DifferenceKey[T any, K comparable](s, other Slice[T], key func(T) K) Slice[T] {
+	exclude := make(map[K]struct{}, len(other))
+	for _, v := range other {
+		exclude[key(v)] = struct{}{}
+	}
+	seen := make(map[K]struct{}, len(s))
+	result := make(Slice[T], 0)
+	for _, v := range s {
+		k := key(v)
+		if _, ok := exclude[k]; ok {
+			continue
+		}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// The Difference() function returns a new slice of the distinct elements of s that are not present in other, in the order they appear in s. See also DifferenceKey() for non-comparable T.
+func Difference[T comparable](s, other Slice[T]) Slice[T] {
+	return DifferenceKey(s, other, func(v T) T { return v })
+}
+
+/*
+The SymmetricDifferenceKey() function returns a new slice of the distinct elements whose key is present in exactly one of s and other, in the order s then other.
+
+The key function extracts the comparable key used to match elements. See also SymmetricDifference() for the comparable T case.
+*/
+func SymmetricDifferenceKey[T any, K comparable](s, other Slice[T], key func(T) K) Slice[T] {
+	result := DifferenceKey(s, other, key)
+	result = append(result, DifferenceKey(other, s, key)...)
+	return result
+}
+
+// The SymmetricDifference() function returns a new slice of the distinct elements present in exactly one of s and other, in the order s then other. See also SymmetricDifferenceKey() for non-comparable T.
+func SymmetricDifference[T comparable](s, other Slice[T]) Slice[T] {
+	return SymmetricDifferenceKey(s, other, func(v T) T { return v })
+}
+
+/*
+The ContentEqualKey() function reports whether s and other contain the same multiset of keys, regardless of order.
+
+The key function extracts the comparable key used to compare elements. See also ContentEqual() for the comparable T case.
+*/
+func ContentEqualKey[T any, K comparable](s, other Slice[T], key func(T) K) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	counts := make(map[K]int, len(s))
+	for _, v := range s {
+		counts[key(v)]++
+	}
+	for _, v := range other {
+		k := key(v)
+		counts[k]--
+		if counts[k] < 0 {
+			return false
+		}
+	}
+	for _, c := range counts {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// The ContentEqual() function reports whether s and other contain the same multiset of elements, regardless of order. See also ContentEqualKey() for non-comparable T.
+func ContentEqual[T comparable](s, other Slice[T]) bool {
+	return ContentEqualKey(s, other, func(v T) T { return v })
+}
+
+// The Iter() method returns a lazy iterator.Seq over the slice's elements, for chaining lazy transforms without allocating intermediate slices. See also Collect().
+func (s Slice[T]) Iter() iterator.Seq[T] {
+	return iterator.From(s)
+}
+
+/*
+The Collect() method pulls every element produced by seq and appends it to the slice, returning the modified slice. See also Iter().
+*/
+func (s *Slice[T]) Collect(seq iterator.Seq[T]) *Slice[T] {
+	seq(func(v T) bool {
+		*s = append(*s, v)
+		return true
+	})
+	return s
+}
+
+// normalizeIndex clamps a possibly negative JS-style index (counted from the end) to the range [0, length].
+func normalizeIndex(i, length int) int {
+	if i < 0 {
+		i += length
+		if i < 0 {
+			i = 0
+		}
+	}
+	if i > length {
+		i = length
+	}
+	return i
+}
+
+/*
+The Pull() method removes every element that matches the given predicate from the slice, in place, and returns the modified slice. See also PullAt() and PullAllBy().
+
+The match function is called for each element. If it returns true, the element is removed.
+*/
+func (s *Slice[T]) Pull(match func(T) bool) *Slice[T] {
+	l := len(*s)
+	result := (*s)[:0]
+	for _, v := range *s {
+		if !match(v) {
+			result = append(result, v)
+		}
+	}
+	var zero T
+	for i := len(result); i < l; i++ {
+		(*s)[i] = zero
+	}
+	*s = result
+	return s
+}
+
+/*
+The PullAt() method removes the elements at the given indexes from the slice, in place, and returns the removed elements in ascending index order. Out-of-range indexes are ignored. See also Pull() and PullAllBy().
+*/
+func (s *Slice[T]) PullAt(indexes ...int) Slice[T] {
+	l := len(*s)
+	remove := make(map[int]struct{}, len(indexes))
+	for _, i := range indexes {
+		if i < 0 || i >= l {
+			continue
+		}
+		remove[i] = struct{}{}
+	}
+	removed := make(Slice[T], 0, len(remove))
+	result := make(Slice[T], 0, l-len(remove))
+	for i, v := range *s {
+		if _, ok := remove[i]; ok {
+			removed = append(removed, v)
+		} else {
+			result = append(result, v)
+		}
+	}
+	*s = result
+	return removed
+}
+
+/*
+The PullAllBy() function removes every element of s whose key matches the key of any element in values, in place, and returns the modified slice. See also Pull() and PullAt().
+
+The key function extracts the comparable key used to match elements.
+*/
+func PullAllBy[T any, K comparable](s *Slice[T], key func(T) K, values ...T) *Slice[T] {
+	exclude := make(map[K]struct{}, len(values))
+	for _, v := range values {
+		exclude[key(v)] = struct{}{}
+	}
+	l := len(*s)
+	result := (*s)[:0]
+	for _, v := range *s {
+		if _, ok := exclude[key(v)]; !ok {
+			result = append(result, v)
+		}
+	}
+	var zero T
+	for i := len(result); i < l; i++ {
+		(*s)[i] = zero
+	}
+	*s = result
+	return s
+}
+
+// The FlatMap() function applies f to each element of s and concatenates the resulting slices into a single result slice, in order.
+func FlatMap[T, U any](s Slice[T], f func(T) Slice[U]) Slice[U] {
+	result := make(Slice[U], 0, len(s))
+	for _, v := range s {
+		result = append(result, f(v)...)
+	}
+	return result
+}
+
+/*
+The CopyWithin() method copies the elements in the range [start, end) to position target, in place, and returns the modified slice. end defaults to the length of the slice.
+
+Following the JavaScript Array.prototype.copyWithin spec, target, start and end may be negative, in which case they count back from the end of the slice. The copy is truncated if it would run past the end of the slice.
+*/
+func (s *Slice[T]) CopyWithin(target, start int, end ...int) *Slice[T] {
+	l := len(*s)
+	e := l
+	if len(end) > 0 {
+		e = end[0]
+	}
+	target = normalizeIndex(target, l)
+	start = normalizeIndex(start, l)
+	e = normalizeIndex(e, l)
+	if start >= e || target >= l {
+		return s
+	}
+	count := e - start
+	if count > l-target {
+		count = l - target
+	}
+	copy((*s)[target:target+count], (*s)[start:start+count])
+	return s
+}
+
+/*
+The Fill() method overwrites the elements in the range [start, end) with value, in place, and returns the modified slice. start defaults to 0 and end defaults to the length of the slice.
+
+Following the JavaScript Array.prototype.fill spec, start and end may be negative, in which case they count back from the end of the slice.
+*/
+func (s *Slice[T]) Fill(value T, startEnd ...int) *Slice[T] {
+	l := len(*s)
+	start, end := 0, l
+	if len(startEnd) > 0 {
+		start = startEnd[0]
+	}
+	if len(startEnd) > 1 {
+		end = startEnd[1]
+	}
+	start = normalizeIndex(start, l)
+	end = normalizeIndex(end, l)
+	for i := start; i < end; i++ {
+		(*s)[i] = value
+	}
+	return s
+}
+
+// The GroupBy() function buckets the elements of s by key, preserving the order of first appearance within each bucket.
+func GroupBy[T any, K comparable](s Slice[T], key func(T) K) map[K]Slice[T] {
+	groups := make(map[K]Slice[T])
+	for _, v := range s {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// The KeyBy() function indexes the elements of s by key. If multiple elements share a key, the last one wins.
+func KeyBy[T any, K comparable](s Slice[T], key func(T) K) map[K]T {
+	result := make(map[K]T, len(s))
+	for _, v := range s {
+		result[key(v)] = v
+	}
+	return result
+}
+
+// The Partition() function splits s into two slices: the elements for which pred returns true, and the rest, each preserving their relative order.
+func Partition[T any](s Slice[T], pred func(T) bool) (Slice[T], Slice[T]) {
+	matched := make(Slice[T], 0)
+	rest := make(Slice[T], 0)
+	for _, v := range s {
+		if pred(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+}
+
+/*
+The Chunk() function splits s into consecutive, non-overlapping chunks of size elements each. The last chunk may be shorter. It panics if size<=0.
+*/
+func Chunk[T any](s Slice[T], size int) Slice[Slice[T]] {
+	if size <= 0 {
+		panic("slice.Chunk: size must be positive")
+	}
+	chunks := make(Slice[Slice[T]], 0, (len(s)+size-1)/size)
+	for i := 0; i < len(s); i += size {
+		end := i + size
+		if end > len(s) {
+			end = len(s)
+		}
+		chunks = append(chunks, s[i:end])
+	}
+	return chunks
+}
+
+// normalizeWorkerCount clamps workers to at least 1 and, when total is known, to at most total.
+func normalizeWorkerCount(workers, total int) int {
+	if workers < 1 {
+		workers = 1
+	}
+	if total > 0 && workers > total {
+		workers = total
+	}
+	return workers
+}
+
+/*
+The MapParallel() method is the concurrent counterpart to Map(). It applies change to each element using workers goroutines and returns a new slice with the results in the original order. See also MapParallelCtx() for a cancellable, error-aware variant.
+*/
+func (s Slice[T]) MapParallel(change func(T) T, workers int) Slice[T] {
+	result := make(Slice[T], len(s))
+	workers = normalizeWorkerCount(workers, len(s))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result[i] = change(s[i])
+			}
+		}()
+	}
+	for i := range s {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return result
+}
+
+/*
+The FilterParallel() method is the concurrent counterpart to Filter(). It tests each element using workers goroutines and returns a new slice of the matching elements, preserving their original order.
+*/
+func (s Slice[T]) FilterParallel(match func(T) bool, workers int) Slice[T] {
+	keep := make([]bool, len(s))
+	workers = normalizeWorkerCount(workers, len(s))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				keep[i] = match(s[i])
+			}
+		}()
+	}
+	for i := range s {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	result := make(Slice[T], 0, len(s))
+	for i, k := range keep {
+		if k {
+			result = append(result, s[i])
+		}
+	}
+	return result
+}
+
+// The ForEachParallel() method calls fn for each element using workers goroutines, blocking until every call has returned. Elements may be visited in any order.
+func (s Slice[T]) ForEachParallel(fn func(T), workers int) {
+	workers = normalizeWorkerCount(workers, len(s))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(s[i])
+			}
+		}()
+	}
+	for i := range s {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+/*
+The MapParallelCtx() method is a context-aware, error-propagating variant of MapParallel(). It applies change to each element using workers goroutines and returns a new slice with the results in the original order.
+
+If change returns an error for any element, the context passed to the remaining calls is cancelled, outstanding work winds down, and MapParallelCtx returns the first error encountered.
+If ctx itself is cancelled before all workers have finished, MapParallelCtx returns ctx.Err() and no result.
+*/
+func (s Slice[T]) MapParallelCtx(ctx context.Context, change func(context.Context, T) (T, error), workers int) (Slice[T], error) {
+	result := make(Slice[T], len(s))
+	workers = normalizeWorkerCount(workers, len(s))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				v, err := change(ctx, s[i])
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				result[i] = v
+			}
+		}()
+	}
+
+feed:
+	for i := range s {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
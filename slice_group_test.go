@@ -0,0 +1,70 @@
+package slice
+
+import "testing"
+
+func TestGroupByPreservesBucketOrder(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4, 5, 6}
+	got := GroupBy(s, func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	wantOdd := Slice[int]{1, 3, 5}
+	wantEven := Slice[int]{2, 4, 6}
+	if !Equal(got["odd"], wantOdd) {
+		t.Errorf(`GroupBy()["odd"] = %v, want %v`, got["odd"], wantOdd)
+	}
+	if !Equal(got["even"], wantEven) {
+		t.Errorf(`GroupBy()["even"] = %v, want %v`, got["even"], wantEven)
+	}
+}
+
+func TestKeyByLastWriteWins(t *testing.T) {
+	type item struct {
+		id   int
+		name string
+	}
+	s := Slice[item]{{1, "a"}, {2, "b"}, {1, "c"}}
+	got := KeyBy(s, func(v item) int { return v.id })
+	if got[1] != (item{1, "c"}) {
+		t.Errorf("KeyBy()[1] = %v, want %v", got[1], item{1, "c"})
+	}
+	if got[2] != (item{2, "b"}) {
+		t.Errorf("KeyBy()[2] = %v, want %v", got[2], item{2, "b"})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	matched, rest := Partition(Slice[int]{1, 2, 3, 4, 5}, func(v int) bool { return v%2 == 0 })
+	wantMatched := Slice[int]{2, 4}
+	wantRest := Slice[int]{1, 3, 5}
+	if !Equal(matched, wantMatched) {
+		t.Errorf("Partition() matched = %v, want %v", matched, wantMatched)
+	}
+	if !Equal(rest, wantRest) {
+		t.Errorf("Partition() rest = %v, want %v", rest, wantRest)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	got := Chunk(Slice[int]{1, 2, 3, 4, 5}, 2)
+	want := Slice[Slice[int]]{{1, 2}, {3, 4}, {5}}
+	if len(got) != len(want) {
+		t.Fatalf("Chunk() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !Equal(got[i], want[i]) {
+			t.Fatalf("Chunk() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestChunkPanicsOnNonPositiveSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Chunk() with size<=0 did not panic")
+		}
+	}()
+	Chunk(Slice[int]{1, 2, 3}, 0)
+}
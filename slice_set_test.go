@@ -0,0 +1,80 @@
+package slice
+
+import "testing"
+
+func TestDistinct(t *testing.T) {
+	got := Distinct(Slice[int]{1, 2, 2, 3, 1})
+	want := Slice[int]{1, 2, 3}
+	if !Equal(got, want) {
+		t.Errorf("Distinct() = %v, want %v", got, want)
+	}
+}
+
+func TestDistinctKey(t *testing.T) {
+	type item struct {
+		id   int
+		name string
+	}
+	s := Slice[item]{{1, "a"}, {2, "b"}, {1, "c"}}
+	got := DistinctKey(s, func(v item) int { return v.id })
+	want := Slice[item]{{1, "a"}, {2, "b"}}
+	if len(got) != len(want) {
+		t.Fatalf("DistinctKey() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("DistinctKey() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestUnion(t *testing.T) {
+	got := Union(Slice[int]{1, 2, 3}, Slice[int]{3, 4, 2, 5})
+	want := Slice[int]{1, 2, 3, 4, 5}
+	if !Equal(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	got := Intersection(Slice[int]{1, 2, 2, 3}, Slice[int]{2, 3, 4})
+	want := Slice[int]{2, 3}
+	if !Equal(got, want) {
+		t.Errorf("Intersection() = %v, want %v", got, want)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	got := Difference(Slice[int]{1, 2, 3}, Slice[int]{2, 4})
+	want := Slice[int]{1, 3}
+	if !Equal(got, want) {
+		t.Errorf("Difference() = %v, want %v", got, want)
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	got := SymmetricDifference(Slice[int]{1, 2, 3}, Slice[int]{2, 3, 4})
+	want := Slice[int]{1, 4}
+	if !Equal(got, want) {
+		t.Errorf("SymmetricDifference() = %v, want %v", got, want)
+	}
+}
+
+func TestContentEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Slice[int]
+		want bool
+	}{
+		{"same multiset, different order", Slice[int]{1, 2, 2, 3}, Slice[int]{3, 2, 1, 2}, true},
+		{"different length", Slice[int]{1, 2}, Slice[int]{1, 2, 3}, false},
+		{"same length, different counts", Slice[int]{1, 1, 2}, Slice[int]{1, 2, 2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContentEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("ContentEqual(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestBinarySearch(t *testing.T) {
+	s := Slice[int]{1, 3, 3, 5, 7, 9}
+	tests := []struct {
+		target  int
+		wantIdx int
+		wantOk  bool
+	}{
+		{1, 0, true},
+		{3, 1, true},
+		{4, 3, false},
+		{9, 5, true},
+		{10, 6, false},
+		{0, 0, false},
+	}
+	for _, tt := range tests {
+		idx, ok := BinarySearch(s, tt.target)
+		if idx != tt.wantIdx || ok != tt.wantOk {
+			t.Errorf("BinarySearch(%d) = (%d, %v), want (%d, %v)", tt.target, idx, ok, tt.wantIdx, tt.wantOk)
+		}
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	s := Slice[int]{9, 7, 5, 3, 1}
+	descending := func(a, b int) int { return b - a }
+
+	if idx, ok := BinarySearchFunc(s, 3, descending); !ok || idx != 3 {
+		t.Errorf("BinarySearchFunc(3) = (%d, %v), want (3, true)", idx, ok)
+	}
+	if idx, ok := BinarySearchFunc(s, 4, descending); ok || idx != 3 {
+		t.Errorf("BinarySearchFunc(4) = (%d, %v), want (3, false)", idx, ok)
+	}
+}
+
+func TestBinarySearchReturnsLeftmostIndex(t *testing.T) {
+	s := Slice[int]{1, 2, 2, 2, 3}
+	if idx, ok := BinarySearch(s, 2); !ok || idx != 1 {
+		t.Errorf("BinarySearch(2) = (%d, %v), want (1, true)", idx, ok)
+	}
+}
+
+func TestCopyWithin(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      Slice[int]
+		target int
+		start  int
+		end    []int
+		want   Slice[int]
+	}{
+		{"no end, positive indexes", Slice[int]{1, 2, 3, 4, 5}, 0, 3, nil, Slice[int]{4, 5, 3, 4, 5}},
+		{"negative indexes", Slice[int]{1, 2, 3, 4, 5}, -2, -3, []int{-1}, Slice[int]{1, 2, 3, 3, 4}},
+		{"truncated at end of slice", Slice[int]{1, 2, 3, 4, 5}, 3, 0, nil, Slice[int]{1, 2, 3, 1, 2}},
+		{"target out of range is a no-op", Slice[int]{1, 2, 3}, 5, 0, nil, Slice[int]{1, 2, 3}},
+		{"start >= end is a no-op", Slice[int]{1, 2, 3}, 0, 2, []int{1}, Slice[int]{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.s.CopyWithin(tt.target, tt.start, tt.end...)
+			if !Equal(*got, tt.want) {
+				t.Errorf("CopyWithin(%d, %d, %v) = %v, want %v", tt.target, tt.start, tt.end, *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFill(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        Slice[int]
+		value    int
+		startEnd []int
+		want     Slice[int]
+	}{
+		{"full slice, no range", Slice[int]{1, 2, 3, 4, 5}, 0, nil, Slice[int]{0, 0, 0, 0, 0}},
+		{"positive start and end", Slice[int]{1, 2, 3, 4, 5}, 0, []int{1, 3}, Slice[int]{1, 0, 0, 4, 5}},
+		{"negative start, default end", Slice[int]{1, 2, 3, 4, 5}, 0, []int{-2}, Slice[int]{1, 2, 3, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.s.Fill(tt.value, tt.startEnd...)
+			if !Equal(*got, tt.want) {
+				t.Errorf("Fill(%d, %v) = %v, want %v", tt.value, tt.startEnd, *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapParallelPreservesOrder(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4, 5, 6, 7, 8}
+	got := s.MapParallel(func(v int) int { return v * v }, 4)
+	want := Slice[int]{1, 4, 9, 16, 25, 36, 49, 64}
+	if !Equal(got, want) {
+		t.Errorf("MapParallel() = %v, want %v", got, want)
+	}
+}
+
+func TestFilterParallelPreservesOrder(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	got := s.FilterParallel(func(v int) bool { return v%2 == 0 }, 3)
+	want := Slice[int]{2, 4, 6, 8, 10}
+	if !Equal(got, want) {
+		t.Errorf("FilterParallel() = %v, want %v", got, want)
+	}
+}
+
+func TestForEachParallel(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4, 5}
+	var mu sync.Mutex
+	sum := 0
+	s.ForEachParallel(func(v int) {
+		mu.Lock()
+		sum += v
+		mu.Unlock()
+	}, 3)
+	if sum != 15 {
+		t.Errorf("ForEachParallel() summed to %d, want 15", sum)
+	}
+}
+
+func TestMapParallelCtxPreservesOrder(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4, 5, 6, 7, 8}
+	got, err := s.MapParallelCtx(context.Background(), func(ctx context.Context, v int) (int, error) {
+		return v * 2, nil
+	}, 4)
+	if err != nil {
+		t.Fatalf("MapParallelCtx() error = %v, want nil", err)
+	}
+	want := Slice[int]{2, 4, 6, 8, 10, 12, 14, 16}
+	if !Equal(got, want) {
+		t.Errorf("MapParallelCtx() = %v, want %v", got, want)
+	}
+}
+
+func TestMapParallelCtxReturnsFirstError(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4, 5, 6, 7, 8}
+	wantErr := errors.New("boom")
+	_, err := s.MapParallelCtx(context.Background(), func(ctx context.Context, v int) (int, error) {
+		if v == 4 {
+			return 0, wantErr
+		}
+		return v, nil
+	}, 4)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("MapParallelCtx() error = %v, want %v", err, wantErr)
+	}
+}